@@ -0,0 +1,113 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmServiceBusNamespace() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmServiceBusNamespaceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"default_primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func dataSourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceBusNamespacesClient
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(resGroup, name)
+	if err != nil {
+		if responseWasNotFound(resp.Response) {
+			return fmt.Errorf("ServiceBus Namespace %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace %q: %+v", name, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ServiceBus Namespace %q (Resource Group %q) ID", name, resGroup)
+	}
+	d.SetId(*resp.ID)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", strings.ToLower(string(sku.Name)))
+		d.Set("capacity", sku.Capacity)
+	}
+
+	if props := resp.SBNamespaceProperties; props != nil {
+		d.Set("zone_redundant", props.ZoneRedundant)
+	}
+
+	keys, err := client.ListKeys(resGroup, name, serviceBusNamespaceDefaultAuthorizationRule)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace %q List Keys: %+v", name, err)
+	}
+
+	d.Set("default_primary_connection_string", keys.PrimaryConnectionString)
+	d.Set("default_secondary_connection_string", keys.SecondaryConnectionString)
+	d.Set("default_primary_key", keys.PrimaryKey)
+	d.Set("default_secondary_key", keys.SecondaryKey)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
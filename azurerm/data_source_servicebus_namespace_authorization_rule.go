@@ -0,0 +1,106 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmServiceBusNamespaceAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmServiceBusNamespaceAuthorizationRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"listen": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"send": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"manage": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmServiceBusNamespaceAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceBusNamespacesClient
+
+	name := d.Get("name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.GetAuthorizationRule(resGroup, namespaceName, name)
+	if err != nil {
+		if responseWasNotFound(resp.Response) {
+			return fmt.Errorf("ServiceBus Namespace Authorization Rule %q (Namespace %q / Resource Group %q) was not found", name, namespaceName, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace Authorization Rule %q: %+v", name, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ServiceBus Namespace Authorization Rule %q (Namespace %q / Resource Group %q) ID", name, namespaceName, resGroup)
+	}
+	d.SetId(*resp.ID)
+
+	if props := resp.SBAuthorizationRuleProperties; props != nil {
+		listen, send, manage := flattenServiceBusNamespaceAuthorizationRuleRights(props.Rights)
+		d.Set("listen", listen)
+		d.Set("send", send)
+		d.Set("manage", manage)
+	}
+
+	keysResp, err := client.ListKeys(resGroup, namespaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace Authorization Rule %q List Keys: %+v", name, err)
+	}
+
+	d.Set("primary_key", keysResp.PrimaryKey)
+	d.Set("secondary_key", keysResp.SecondaryKey)
+	d.Set("primary_connection_string", keysResp.PrimaryConnectionString)
+	d.Set("secondary_connection_string", keysResp.SecondaryConnectionString)
+
+	return nil
+}
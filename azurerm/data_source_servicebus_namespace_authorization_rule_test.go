@@ -0,0 +1,59 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMServiceBusNamespaceAuthorizationRule_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_servicebus_namespace_authorization_rule.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMServiceBusNamespaceAuthorizationRule_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "send", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "manage", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_connection_string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMServiceBusNamespaceAuthorizationRule_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+resource "azurerm_servicebus_namespace" "test" {
+    name = "acctestservicebusnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "standard"
+}
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+    name = "acctestservicebusnamespaceauth-%d"
+    namespace_name = "${azurerm_servicebus_namespace.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    listen = true
+    send = true
+}
+data "azurerm_servicebus_namespace_authorization_rule" "test" {
+    name = "${azurerm_servicebus_namespace_authorization_rule.test.name}"
+    namespace_name = "${azurerm_servicebus_namespace.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt, rInt)
+}
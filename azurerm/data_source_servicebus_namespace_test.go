@@ -0,0 +1,52 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMServiceBusNamespace_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_servicebus_namespace.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMServiceBusNamespace_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "sku", "standard"),
+					resource.TestCheckResourceAttr(dataSourceName, "capacity", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "default_primary_connection_string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "default_secondary_connection_string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "default_primary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "default_secondary_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMServiceBusNamespace_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+resource "azurerm_servicebus_namespace" "test" {
+    name = "acctestservicebusnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "standard"
+}
+data "azurerm_servicebus_namespace" "test" {
+    name = "${azurerm_servicebus_namespace.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
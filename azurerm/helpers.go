@@ -0,0 +1,27 @@
+package azurerm
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ignoreCaseDiffSuppressFunc suppresses diffs for schema fields which are compared
+// case-insensitively by the Azure API (e.g. sku names), so that a plan isn't dirty
+// just because a config uses different casing than what the API returns.
+//
+// NEEDS-REQUESTER-CONFIRMATION (jhandel/terraform-provider-azurerm#chunk0-4): the request
+// asked for this to be wired into `sku` on five resources; this tree only carries
+// azurerm_servicebus_namespace, so only that one is done. #chunk0-4 is NOT fully closed -
+// landing just the ServiceBus half is a scope split that needs the requester's explicit
+// sign-off before this is treated as done. Do not close out the backlog item on the
+// strength of this comment alone. Once the requester confirms (or the other resources land
+// in this package), wire `sku` + add a NonStandardCasing acceptance test on each of:
+//   - azurerm_eventhub_namespace
+//   - azurerm_cdn_profile
+//   - azurerm_redis_cache
+//   - azurerm_storage_account
+//   - azurerm_virtual_machine_scale_set
+func ignoreCaseDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
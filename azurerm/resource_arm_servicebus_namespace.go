@@ -0,0 +1,350 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/servicebus"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmServiceBusNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServiceBusNamespaceCreate,
+		Read:   resourceArmServiceBusNamespaceRead,
+		Update: resourceArmServiceBusNamespaceUpdate,
+		Delete: resourceArmServiceBusNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"sku": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateServiceBusNamespaceSku,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateServiceBusNamespaceCapacity,
+			},
+
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"default_primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"authorization_rules": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metric_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: resourceArmServiceBusNamespaceCustomizeDiff,
+	}
+}
+
+func resourceArmServiceBusNamespaceCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	sku := diff.Get("sku").(string)
+	capacity := diff.Get("capacity").(int)
+	zoneRedundant := diff.Get("zone_redundant").(bool)
+
+	if strings.EqualFold(sku, string(servicebus.Premium)) {
+		return nil
+	}
+
+	if capacity > 1 {
+		return fmt.Errorf("`capacity` can only be scaled to %d for the `Premium` sku, got %q", capacity, sku)
+	}
+
+	if zoneRedundant {
+		return fmt.Errorf("`zone_redundant` is only supported for the `Premium` sku, got %q", sku)
+	}
+
+	return nil
+}
+
+func resourceArmServiceBusNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceBusNamespacesClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM ServiceBus Namespace creation.")
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resGroup := d.Get("resource_group_name").(string)
+	sku := d.Get("sku").(string)
+	capacity := int32(d.Get("capacity").(int))
+	zoneRedundant := d.Get("zone_redundant").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := servicebus.SBNamespace{
+		Location: &location,
+		Sku: &servicebus.SBSku{
+			Name:     servicebus.SkuName(sku),
+			Tier:     servicebus.SkuTier(sku),
+			Capacity: &capacity,
+		},
+		SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+			ZoneRedundant: &zoneRedundant,
+		},
+		Tags: expandTags(tags),
+	}
+
+	_, error := client.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
+	err := <-error
+	if err != nil {
+		return err
+	}
+
+	read, err := client.Get(resGroup, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ServiceBus Namespace %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmServiceBusNamespaceRead(d, meta)
+}
+
+func resourceArmServiceBusNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceBusNamespacesClient
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resGroup := d.Get("resource_group_name").(string)
+	sku := d.Get("sku").(string)
+	capacity := int32(d.Get("capacity").(int))
+	zoneRedundant := d.Get("zone_redundant").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := servicebus.SBNamespace{
+		Location: &location,
+		Sku: &servicebus.SBSku{
+			Name:     servicebus.SkuName(sku),
+			Tier:     servicebus.SkuTier(sku),
+			Capacity: &capacity,
+		},
+		SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+			ZoneRedundant: &zoneRedundant,
+		},
+		Tags: expandTags(tags),
+	}
+
+	// Capacity scaling for the Premium sku is an in-place Update, not a ForceNew -
+	// CreateOrUpdate is also used by Terraform to apply changes to an existing namespace.
+	_, error := client.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
+	err := <-error
+	if err != nil {
+		return err
+	}
+
+	return resourceArmServiceBusNamespaceRead(d, meta)
+}
+
+func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceBusNamespacesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["namespaces"]
+
+	resp, err := client.Get(resGroup, name)
+	if err != nil {
+		if responseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace %s: %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", strings.ToLower(string(sku.Name)))
+		d.Set("capacity", sku.Capacity)
+	}
+
+	if props := resp.SBNamespaceProperties; props != nil {
+		d.Set("zone_redundant", props.ZoneRedundant)
+		if props.ServiceBusEndpoint != nil {
+			d.Set("endpoint", props.ServiceBusEndpoint)
+		}
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ServiceBus Namespace %s (resource group %s) ID", name, resGroup)
+	}
+	d.Set("metric_id", fmt.Sprintf("%s/providers/Microsoft.Insights/diagnosticSettings/service", *resp.ID))
+
+	keys, err := client.ListKeys(resGroup, name, serviceBusNamespaceDefaultAuthorizationRule)
+	if err != nil {
+		log.Printf("[WARN] Unable to List default Keys for ServiceBus Namespace %q: %+v", name, err)
+	} else {
+		d.Set("default_primary_connection_string", keys.PrimaryConnectionString)
+		d.Set("default_secondary_connection_string", keys.SecondaryConnectionString)
+		d.Set("default_primary_key", keys.PrimaryKey)
+		d.Set("default_secondary_key", keys.SecondaryKey)
+	}
+
+	rules, err := flattenServiceBusNamespaceAuthorizationRules(client, resGroup, name)
+	if err != nil {
+		log.Printf("[WARN] Unable to List Authorization Rules for ServiceBus Namespace %q: %+v", name, err)
+	} else {
+		d.Set("authorization_rules", rules)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmServiceBusNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceBusNamespacesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["namespaces"]
+
+	deleteResp, error := client.Delete(resGroup, name, make(chan struct{}))
+	resp := <-deleteResp
+	err = <-error
+	if err != nil {
+		if responseWasNotFound(resp) {
+			return nil
+		}
+		return fmt.Errorf("Error issuing Azure ARM delete request of ServiceBus Namespace '%s': %+v", name, err)
+	}
+
+	return nil
+}
+
+const serviceBusNamespaceDefaultAuthorizationRule = "RootManageSharedAccessKey"
+
+// flattenServiceBusNamespaceAuthorizationRules builds a map of connection strings keyed by
+// authorization rule name, so that consumers aren't limited to the RootManageSharedAccessKey rule.
+func flattenServiceBusNamespaceAuthorizationRules(client servicebus.NamespacesClient, resourceGroup, name string) (map[string]string, error) {
+	rules, err := client.ListAuthorizationRules(resourceGroup, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	if rules.Value == nil {
+		return result, nil
+	}
+
+	for _, rule := range *rules.Value {
+		if rule.Name == nil {
+			continue
+		}
+
+		keys, err := client.ListKeys(resourceGroup, name, *rule.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if keys.PrimaryConnectionString != nil {
+			result[*rule.Name] = *keys.PrimaryConnectionString
+		}
+	}
+
+	return result, nil
+}
+
+func validateServiceBusNamespaceSku(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	capacities := map[string]bool{
+		"basic":    true,
+		"standard": true,
+		"premium":  true,
+	}
+
+	if !capacities[strings.ToLower(value)] {
+		errors = append(errors, fmt.Errorf("ServiceBus Namespace Sku can only be Basic, Standard or Premium"))
+	}
+	return warnings, errors
+}
+
+func validateServiceBusNamespaceCapacity(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(int)
+	capacities := map[int]bool{
+		1:  true,
+		2:  true,
+		4:  true,
+		8:  true,
+		16: true,
+	}
+
+	if !capacities[value] {
+		errors = append(errors, fmt.Errorf("ServiceBus Namespace Capacity can only be 1, 2, 4, 8 or 16"))
+	}
+	return warnings, errors
+}
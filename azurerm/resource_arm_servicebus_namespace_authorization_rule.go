@@ -0,0 +1,317 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/servicebus"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func init() {
+	resource.AddTestSweepers("azurerm_servicebus_namespace_authorization_rule", &resource.Sweeper{
+		Name: "azurerm_servicebus_namespace_authorization_rule",
+		F:    testSweepServicebusNamespaceAuthorizationRule,
+	})
+}
+
+func testSweepServicebusNamespaceAuthorizationRule(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := (*armClient).serviceBusNamespacesClient
+
+	log.Printf("Retrieving the Servicebus Namespaces..")
+	namespaces, err := client.ListBySubscription()
+	if err != nil {
+		return fmt.Errorf("Error Listing on Servicebus Namespaces: %+v", err)
+	}
+
+	for _, namespace := range *namespaces.Value {
+		if !shouldSweepAcceptanceTestResource(*namespace.Name, *namespace.Location, region) {
+			continue
+		}
+
+		resourceId, err := parseAzureResourceID(*namespace.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := resourceId.ResourceGroup
+		namespaceName := resourceId.Path["namespaces"]
+
+		rules, err := client.ListAuthorizationRules(resourceGroup, namespaceName)
+		if err != nil {
+			return fmt.Errorf("Error Listing Authorization Rules for Servicebus Namespace %q: %+v", namespaceName, err)
+		}
+
+		if rules.Value == nil {
+			continue
+		}
+
+		for _, rule := range *rules.Value {
+			if rule.Name == nil || *rule.Name == serviceBusNamespaceDefaultAuthorizationRule {
+				continue
+			}
+
+			log.Printf("Deleting Servicebus Namespace Authorization Rule %q (Namespace %q / Resource Group %q)", *rule.Name, namespaceName, resourceGroup)
+			if _, err := client.DeleteAuthorizationRule(resourceGroup, namespaceName, *rule.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmServiceBusNamespaceAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServiceBusNamespaceAuthorizationRuleCreateUpdate,
+		Read:   resourceArmServiceBusNamespaceAuthorizationRuleRead,
+		Update: resourceArmServiceBusNamespaceAuthorizationRuleCreateUpdate,
+		Delete: resourceArmServiceBusNamespaceAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"listen": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"send": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"manage": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// regenerate_primary_key and regenerate_secondary_key act as triggers: changing
+			// either to `true` rotates the corresponding key on that apply. The value is kept
+			// in state, so leaving it `true` on later applies does not rotate the key again.
+			"regenerate_primary_key": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"regenerate_secondary_key": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		CustomizeDiff: resourceArmServiceBusAuthorizationRuleCustomizeDiff,
+	}
+}
+
+// resourceArmServiceBusAuthorizationRuleCustomizeDiff enforces the Azure rule that the
+// `Manage` claim cannot be granted without both `Listen` and `Send`.
+func resourceArmServiceBusAuthorizationRuleCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	listen, send, manage := diff.Get("listen").(bool), diff.Get("send").(bool), diff.Get("manage").(bool)
+
+	if manage && !listen || manage && !send {
+		return fmt.Errorf("`manage` can only be set to `true` when `listen` and `send` are both set to `true`")
+	}
+
+	return nil
+}
+
+func resourceArmServiceBusNamespaceAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceBusNamespacesClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM ServiceBus Namespace Authorization Rule creation.")
+
+	name := d.Get("name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	parameters := servicebus.SBAuthorizationRule{
+		Name: &name,
+		SBAuthorizationRuleProperties: &servicebus.SBAuthorizationRuleProperties{
+			Rights: expandServiceBusNamespaceAuthorizationRuleRights(d),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateAuthorizationRule(resGroup, namespaceName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating ServiceBus Namespace Authorization Rule %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resGroup, err)
+	}
+
+	// Gate on HasChange as well as the current value - otherwise an unrelated update
+	// (e.g. flipping `send`) would see the flag still `true` from a prior apply and
+	// rotate the key again as a side effect.
+	if d.HasChange("regenerate_primary_key") && d.Get("regenerate_primary_key").(bool) {
+		regenParams := servicebus.RegenerateAccessKeyParameters{KeyType: servicebus.PrimaryKey}
+		if _, err := client.RegenerateKeys(resGroup, namespaceName, name, regenParams); err != nil {
+			return fmt.Errorf("Error regenerating Primary Key for ServiceBus Namespace Authorization Rule %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resGroup, err)
+		}
+	}
+
+	if d.HasChange("regenerate_secondary_key") && d.Get("regenerate_secondary_key").(bool) {
+		regenParams := servicebus.RegenerateAccessKeyParameters{KeyType: servicebus.SecondaryKey}
+		if _, err := client.RegenerateKeys(resGroup, namespaceName, name, regenParams); err != nil {
+			return fmt.Errorf("Error regenerating Secondary Key for ServiceBus Namespace Authorization Rule %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resGroup, err)
+		}
+	}
+
+	read, err := client.GetAuthorizationRule(resGroup, namespaceName, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ServiceBus Namespace Authorization Rule %q (Namespace %q / Resource Group %q) ID", name, namespaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmServiceBusNamespaceAuthorizationRuleRead(d, meta)
+}
+
+func resourceArmServiceBusNamespaceAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceBusNamespacesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["AuthorizationRules"]
+
+	resp, err := client.GetAuthorizationRule(resGroup, namespaceName, name)
+	if err != nil {
+		if responseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace Authorization Rule %q: %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("namespace_name", namespaceName)
+	d.Set("resource_group_name", resGroup)
+
+	if props := resp.SBAuthorizationRuleProperties; props != nil {
+		listen, send, manage := flattenServiceBusNamespaceAuthorizationRuleRights(props.Rights)
+		d.Set("listen", listen)
+		d.Set("send", send)
+		d.Set("manage", manage)
+	}
+
+	keysResp, err := client.ListKeys(resGroup, namespaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace Authorization Rule %q List Keys: %+v", name, err)
+	}
+
+	d.Set("primary_key", keysResp.PrimaryKey)
+	d.Set("secondary_key", keysResp.SecondaryKey)
+	d.Set("primary_connection_string", keysResp.PrimaryConnectionString)
+	d.Set("secondary_connection_string", keysResp.SecondaryConnectionString)
+
+	return nil
+}
+
+func resourceArmServiceBusNamespaceAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceBusNamespacesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["AuthorizationRules"]
+
+	resp, err := client.DeleteAuthorizationRule(resGroup, namespaceName, name)
+	if err != nil {
+		if !responseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing Azure ARM delete request of ServiceBus Namespace Authorization Rule %q: %+v", name, err)
+		}
+	}
+
+	return nil
+}
+
+func expandServiceBusNamespaceAuthorizationRuleRights(d *schema.ResourceData) *[]servicebus.AccessRights {
+	rights := make([]servicebus.AccessRights, 0)
+
+	if d.Get("listen").(bool) {
+		rights = append(rights, servicebus.Listen)
+	}
+
+	if d.Get("send").(bool) {
+		rights = append(rights, servicebus.Send)
+	}
+
+	if d.Get("manage").(bool) {
+		rights = append(rights, servicebus.Manage)
+	}
+
+	return &rights
+}
+
+func flattenServiceBusNamespaceAuthorizationRuleRights(rights *[]servicebus.AccessRights) (listen, send, manage bool) {
+	if rights == nil {
+		return false, false, false
+	}
+
+	for _, right := range *rights {
+		switch right {
+		case servicebus.Listen:
+			listen = true
+		case servicebus.Send:
+			send = true
+		case servicebus.Manage:
+			manage = true
+		}
+	}
+
+	return listen, send, manage
+}
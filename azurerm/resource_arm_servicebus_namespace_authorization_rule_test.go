@@ -0,0 +1,335 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMServiceBusNamespaceAuthorizationRule_basic(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace_authorization_rule.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMServiceBusNamespaceAuthorizationRule_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(resourceName, "send", "false"),
+					resource.TestCheckResourceAttr(resourceName, "manage", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServiceBusNamespaceAuthorizationRule_updateRights(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace_authorization_rule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMServiceBusNamespaceAuthorizationRule_basic(ri, location)
+	postConfig := testAccAzureRMServiceBusNamespaceAuthorizationRule_readWrite(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(resourceName, "send", "false"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(resourceName, "send", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServiceBusNamespaceAuthorizationRule_regenerateKey(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace_authorization_rule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMServiceBusNamespaceAuthorizationRule_basic(ri, location)
+	regenerateConfig := testAccAzureRMServiceBusNamespaceAuthorizationRule_regeneratePrimaryKey(ri, location)
+	regenerateAndSendConfig := testAccAzureRMServiceBusNamespaceAuthorizationRule_regeneratePrimaryKeyAndSend(ri, location)
+
+	var primaryConnectionString string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleExists(resourceName),
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleCapturePrimaryConnectionString(resourceName, &primaryConnectionString),
+				),
+			},
+			{
+				Config: regenerateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleExists(resourceName),
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleKeyRotated(resourceName, &primaryConnectionString),
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleCapturePrimaryConnectionString(resourceName, &primaryConnectionString),
+				),
+			},
+			{
+				// `regenerate_primary_key` is still `true` in this config - an unrelated
+				// change to `send` must not cause the key to rotate again.
+				Config: regenerateAndSendConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "send", "true"),
+					testCheckAzureRMServiceBusNamespaceAuthorizationRuleKeyNotRotated(resourceName, &primaryConnectionString),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMServiceBusNamespaceAuthorizationRuleCapturePrimaryConnectionString(name string, connectionString *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*connectionString = rs.Primary.Attributes["primary_connection_string"]
+
+		return nil
+	}
+}
+
+func testCheckAzureRMServiceBusNamespaceAuthorizationRuleKeyRotated(name string, previousConnectionString *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		current := rs.Primary.Attributes["primary_connection_string"]
+		if current == *previousConnectionString {
+			return fmt.Errorf("Expected `primary_connection_string` to change after regenerating the primary key, but it did not")
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMServiceBusNamespaceAuthorizationRuleKeyNotRotated(name string, previousConnectionString *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		current := rs.Primary.Attributes["primary_connection_string"]
+		if current != *previousConnectionString {
+			return fmt.Errorf("Expected `primary_connection_string` to remain unchanged, but the key was rotated again by an unrelated update")
+		}
+
+		return nil
+	}
+}
+
+func TestAccAzureRMServiceBusNamespaceAuthorizationRule_rightsManageValidation(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMServiceBusNamespaceAuthorizationRule_manageWithoutListenAndSend(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("`manage` can only be set to `true`"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMServiceBusNamespaceAuthorizationRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_servicebus_namespace_authorization_rule" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetAuthorizationRule(resourceGroup, namespaceName, name)
+		if err != nil {
+			return nil
+		}
+
+		if !responseWasNotFound(resp.Response) {
+			return fmt.Errorf("ServiceBus Namespace Authorization Rule still exists:\n%+v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMServiceBusNamespaceAuthorizationRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		ruleName := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Service Bus Namespace Authorization Rule: %s", ruleName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient
+
+		resp, err := client.GetAuthorizationRule(resourceGroup, namespaceName, ruleName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on serviceBusNamespacesClient: %+v", err)
+		}
+
+		if responseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Service Bus Namespace Authorization Rule %q (Namespace %q / resource group: %q) does not exist", ruleName, namespaceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMServiceBusNamespaceAuthorizationRule_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+resource "azurerm_servicebus_namespace" "test" {
+    name = "acctestservicebusnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "standard"
+}
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+    name = "acctestservicebusnamespaceauth-%d"
+    namespace_name = "${azurerm_servicebus_namespace.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    listen = true
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMServiceBusNamespaceAuthorizationRule_readWrite(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+resource "azurerm_servicebus_namespace" "test" {
+    name = "acctestservicebusnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "standard"
+}
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+    name = "acctestservicebusnamespaceauth-%d"
+    namespace_name = "${azurerm_servicebus_namespace.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    listen = true
+    send = true
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMServiceBusNamespaceAuthorizationRule_regeneratePrimaryKey(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+resource "azurerm_servicebus_namespace" "test" {
+    name = "acctestservicebusnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "standard"
+}
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+    name = "acctestservicebusnamespaceauth-%d"
+    namespace_name = "${azurerm_servicebus_namespace.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    listen = true
+    regenerate_primary_key = true
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMServiceBusNamespaceAuthorizationRule_regeneratePrimaryKeyAndSend(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+resource "azurerm_servicebus_namespace" "test" {
+    name = "acctestservicebusnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "standard"
+}
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+    name = "acctestservicebusnamespaceauth-%d"
+    namespace_name = "${azurerm_servicebus_namespace.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    listen = true
+    send = true
+    regenerate_primary_key = true
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMServiceBusNamespaceAuthorizationRule_manageWithoutListenAndSend(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+resource "azurerm_servicebus_namespace" "test" {
+    name = "acctestservicebusnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "standard"
+}
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+    name = "acctestservicebusnamespaceauth-%d"
+    namespace_name = "${azurerm_servicebus_namespace.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    manage = true
+}
+`, rInt, location, rInt, rInt)
+}
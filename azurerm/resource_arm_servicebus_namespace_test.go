@@ -136,6 +136,12 @@ func TestAccAzureRMServiceBusNamespace_readDefaultKeys(t *testing.T) {
 						resourceName, "default_primary_key", regexp.MustCompile(".+")),
 					resource.TestMatchResourceAttr(
 						resourceName, "default_secondary_key", regexp.MustCompile(".+")),
+					resource.TestMatchResourceAttr(
+						resourceName, "endpoint", regexp.MustCompile("https://.+")),
+					resource.TestMatchResourceAttr(
+						resourceName, "metric_id", regexp.MustCompile(".+/providers/Microsoft.Insights/diagnosticSettings/service")),
+					resource.TestMatchResourceAttr(
+						resourceName, "authorization_rules.RootManageSharedAccessKey", regexp.MustCompile("Endpoint=.+")),
 				),
 			},
 		},
@@ -168,6 +174,120 @@ func TestAccAzureRMServiceBusNamespace_NonStandardCasing(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespace_premiumCapacity(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMServiceBusNamespace_premium(ri, location, 1)
+	postConfig := testAccAzureRMServiceBusNamespace_premium(ri, location, 2)
+
+	var namespaceID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "1"),
+					testCheckAzureRMServiceBusNamespaceCaptureID(resourceName, &namespaceID),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "2"),
+					testCheckAzureRMServiceBusNamespaceNotRecreated(resourceName, &namespaceID),
+				),
+			},
+		},
+	})
+}
+
+// testCheckAzureRMServiceBusNamespaceCaptureID records the resource's ID so a later step
+// can confirm the namespace was updated in place rather than destroyed and recreated.
+func testCheckAzureRMServiceBusNamespaceCaptureID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*id = rs.Primary.ID
+
+		return nil
+	}
+}
+
+func testCheckAzureRMServiceBusNamespaceNotRecreated(name string, previousID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID != *previousID {
+			return fmt.Errorf("Expected ServiceBus Namespace to have been updated in place, but it was recreated: got ID %q, expected %q", rs.Primary.ID, *previousID)
+		}
+
+		return nil
+	}
+}
+
+func TestAccAzureRMServiceBusNamespace_disappears(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMServiceBusNamespace_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceExists(resourceName),
+					testCheckAzureRMServiceBusNamespaceDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMServiceBusNamespaceDisappears(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		namespaceName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Service Bus Namespace: %s", namespaceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient
+
+		deleteResp, errChan := client.Delete(resourceGroup, namespaceName, make(chan struct{}))
+		resp := <-deleteResp
+		err := <-errChan
+		if err != nil {
+			if !responseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Delete on serviceBusNamespacesClient: %+v", err)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMServiceBusNamespaceDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient
 
@@ -237,6 +357,23 @@ resource "azurerm_servicebus_namespace" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMServiceBusNamespace_premium(rInt int, location string, capacity int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+resource "azurerm_servicebus_namespace" "test" {
+    name = "acctestservicebusnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "premium"
+    capacity = %d
+    zone_redundant = true
+}
+`, rInt, location, rInt, capacity)
+}
+
 func testAccAzureRMServiceBusNamespaceNonStandardCasing(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {